@@ -3,8 +3,11 @@
 package fzf
 
 import (
+	"context"
 	"fmt"
+	"io"
 
+	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	l "github.com/charmbracelet/lipgloss"
 	"github.com/wipdev-tech/gopen/internal/config"
@@ -13,15 +16,104 @@ import (
 var styles = struct {
 	selected l.Style
 	rest     l.Style
-	cursor   l.Style
 	window   l.Style
+	match    l.Style
 }{
 	rest:   l.NewStyle().Faint(true),
-	cursor: l.NewStyle().Blink(true),
 	window: l.NewStyle().PaddingLeft(1).PaddingRight(1).Border(l.RoundedBorder()),
 	selected: l.NewStyle().
 		Foreground(l.Color("255")).
 		Background(l.Color("56")),
+	match: l.NewStyle().Bold(true).Foreground(l.Color("212")),
+}
+
+// dirAliasItem adapts a config.DirAlias to the list.Item interface so it can
+// be held and filtered by a bubbles/list.Model.
+type dirAliasItem struct {
+	config.DirAlias
+}
+
+// FilterValue is one of the list.Item interface methods. Aliases, not paths,
+// are what users type to find a project.
+func (i dirAliasItem) FilterValue() string { return i.Alias }
+
+// itemDelegate renders a dirAliasItem as an alias/path row, reusing
+// `aliasWidth` to line up the path column regardless of alias length and
+// `pathWidth` to truncate long paths to whatever space is left.
+type itemDelegate struct {
+	aliasWidth int
+	pathWidth  int
+}
+
+func (d itemDelegate) Height() int                             { return 1 }
+func (d itemDelegate) Spacing() int                            { return 0 }
+func (d itemDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+// rowFmt is the row layout used by itemDelegate.Render: two leading spaces,
+// the alias column, two spaces, the path column, one trailing space.
+const rowFmt = "  %s  %s "
+
+// rowChrome is the number of literal space columns rowFmt adds around the
+// alias/path columns, i.e. everything in rowFmt besides the two "%s"s.
+const rowChrome = len("  ") + len("  ") + len(" ")
+
+func (d itemDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	item, ok := listItem.(dirAliasItem)
+	if !ok {
+		return
+	}
+
+	alias := l.NewStyle().Width(d.aliasWidth).
+		Render(highlightMatch(item.Alias, m.MatchesForItem(index)))
+	path := l.NewStyle().Width(d.pathWidth).
+		Render(middleEllipsis(item.Path, d.pathWidth))
+	row := fmt.Sprintf(rowFmt, alias, path)
+
+	if index == m.Index() {
+		fmt.Fprint(w, styles.selected.Render(row))
+		return
+	}
+	fmt.Fprint(w, styles.rest.Render(row))
+}
+
+// middleEllipsis truncates `s` to `width` runes, replacing the middle with a
+// single "…" so both the start and end of a path (usually the most
+// identifying parts) stay visible.
+func middleEllipsis(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+
+	runes := []rune(s)
+	if len(runes) <= width {
+		return s
+	}
+	if width == 1 {
+		return "…"
+	}
+
+	left := (width - 1) / 2
+	right := width - 1 - left
+	return string(runes[:left]) + "…" + string(runes[len(runes)-right:])
+}
+
+// highlightMatch renders `str` rune by rune, styling the runes at
+// `matchedIndexes` to show why it matched the current filter value.
+func highlightMatch(str string, matchedIndexes []int) string {
+	matched := make(map[int]bool, len(matchedIndexes))
+	for _, i := range matchedIndexes {
+		matched[i] = true
+	}
+
+	out := ""
+	for i, r := range []rune(str) {
+		if matched[i] {
+			out += styles.match.Render(string(r))
+			continue
+		}
+		out += string(r)
+	}
+	return out
 }
 
 // Model implements the tea.Model interface to be used as the model part of the
@@ -32,124 +124,234 @@ var styles = struct {
 type Model struct {
 	Config      config.C
 	Selected    string
-	searchStr   string
-	selectedIdx int
-	helpShown   bool
-	done        bool
+	list        list.Model
+	aliasWidth  int
+	width       int
+	height      int
+	watchCtx    context.Context
+	watchPath   string
+	watchEvents <-chan config.C
 }
 
-// Init is one of the tea.Model interface methods but not used by the fuzzy
-// finder.
+// selectedMsg is emitted when the user confirms a project, carrying the path
+// of the chosen alias. StartFzf reads it off the final model once the
+// program quits.
+type selectedMsg struct {
+	path string
+}
+
+func selectCmd(path string) tea.Cmd {
+	return func() tea.Msg { return selectedMsg{path: path} }
+}
+
+// watchStartedMsg carries the channel configChangedMsg values arrive on once
+// the background fsnotify watcher is up.
+type watchStartedMsg struct {
+	events <-chan config.C
+}
+
+// configChangedMsg is emitted whenever the watched config file settles after
+// a change.
+type configChangedMsg struct {
+	cfg config.C
+}
+
+func startWatchCmd(ctx context.Context, path string) tea.Cmd {
+	return func() tea.Msg {
+		events, err := watchConfig(ctx, path)
+		if err != nil {
+			return nil
+		}
+		return watchStartedMsg{events: events}
+	}
+}
+
+// waitForChangeCmd blocks until the watcher reports a new config, then
+// re-arms itself the next time it's returned from Update so the finder keeps
+// listening for as long as the program runs.
+func waitForChangeCmd(events <-chan config.C) tea.Cmd {
+	return func() tea.Msg {
+		cfg, ok := <-events
+		if !ok {
+			return nil
+		}
+		return configChangedMsg{cfg: cfg}
+	}
+}
+
+// Init is one of the tea.Model interface methods. It starts the config file
+// watcher when the Model was built with WithWatch.
 func (m Model) Init() tea.Cmd {
-	return nil
+	if m.watchPath == "" {
+		return nil
+	}
+	return startWatchCmd(m.watchCtx, m.watchPath)
 }
 
+// windowChromeX/windowChromeY are the horizontal and vertical space
+// `styles.window`'s rounded border and padding take up around the list: the
+// border costs a column/row on each side, and PaddingLeft/PaddingRight add
+// two more columns with no vertical equivalent.
+const (
+	windowChromeX = 4
+	windowChromeY = 2
+)
+
 // Update is one of the tea.Model interface methods. It triggers updates to the
-// model and its state on keypresses.
+// model and its state on keypresses, delegating cursor movement, pagination
+// and filtering to the embedded list.Model.
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
-	case tea.KeyMsg:
-		switch msg.String() {
-		case "ctrl+c":
-			m.done = true
-			return m, tea.Quit
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.resize()
+		return m, nil
 
-		case "ctrl+w":
-			m.searchStr = ""
+	case selectedMsg:
+		m.Selected = msg.path
+		return m, tea.Quit
 
-		case "up", "ctrl+p":
-			if m.selectedIdx > 0 {
-				m.selectedIdx--
-			}
+	case watchStartedMsg:
+		m.watchEvents = msg.events
+		return m, waitForChangeCmd(m.watchEvents)
 
-		case "down", "ctrl+n":
-			if m.selectedIdx < 9 && m.selectedIdx < len(m.Config.DirAliases)-1 {
-				m.selectedIdx++
-			}
+	case configChangedMsg:
+		m.Config = msg.cfg
+		filterCmd := m.rebuild()
+		return m, tea.Batch(filterCmd, waitForChangeCmd(m.watchEvents))
 
-		case "enter":
-			m.done = true
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c":
 			return m, tea.Quit
 
-		case "backspace":
-			if len(m.searchStr) >= 1 {
-				m.searchStr = m.searchStr[:len(m.searchStr)-1]
+		case "enter":
+			if m.list.FilterState() != list.Filtering {
+				if i, ok := m.list.SelectedItem().(dirAliasItem); ok {
+					return m, selectCmd(i.Path)
+				}
 			}
+		}
+	}
 
-		case "?":
-			m.helpShown = !m.helpShown
+	var cmd tea.Cmd
+	m.list, cmd = m.list.Update(msg)
+	return m, cmd
+}
 
-		default:
-			if len(msg.String()) == 1 {
-				m.searchStr += msg.String()
-				m.Selected = m.searchStr
-			}
-		}
+// resize fits the embedded list and its item delegate to the model's current
+// width/height, truncating the path column rather than letting rows wrap.
+func (m *Model) resize() {
+	listWidth := m.width - windowChromeX
+	listHeight := m.height - windowChromeY
+	if listWidth < 0 {
+		listWidth = 0
+	}
+	if listHeight < 0 {
+		listHeight = 0
 	}
+	m.list.SetSize(listWidth, listHeight)
 
-	return m, nil
+	pathWidth := listWidth - m.aliasWidth - rowChrome
+	if pathWidth < 1 {
+		pathWidth = 1
+	}
+	m.list.SetDelegate(itemDelegate{aliasWidth: m.aliasWidth, pathWidth: pathWidth})
+}
+
+// rebuild refreshes the list's items and column widths after the underlying
+// config has changed, keeping the current filter value and resizing the
+// delegate to the model's last known window size. It returns the tea.Cmd
+// bubbles/list uses to re-run the active filter against the new items, which
+// callers must not drop.
+func (m *Model) rebuild() tea.Cmd {
+	items, aliasWidth := buildItems(m.Config.DirAliases)
+	m.aliasWidth = aliasWidth
+	cmd := m.list.SetItems(items)
+	m.resize()
+	return cmd
 }
 
 // View is one of the tea.Model interface methods. It includes the rendering logic.
 func (m Model) View() string {
-	s := fmt.Sprintf("Which project do you want to open?\n> %s", m.searchStr)
-	if !m.done {
-		s += styles.cursor.Render("█")
-	}
-	s += "\n\n"
+	return styles.window.Width(m.width).Height(m.height).Render(m.list.View()) + "\n"
+}
 
-	maxLenAlias := 0
-	maxLenPath := 0
-	for _, a := range m.Config.DirAliases {
-		if len(a.Alias) > maxLenAlias {
-			maxLenAlias = len(a.Alias)
-		}
-		if len(a.Path) > maxLenPath {
-			maxLenPath = len(a.Path)
+// buildItems converts aliases to list.Items and reports the width of the
+// widest alias, so the alias column lines up regardless of which aliases are
+// currently shown.
+func buildItems(aliases []config.DirAlias) ([]list.Item, int) {
+	aliasWidth := 0
+	items := make([]list.Item, len(aliases))
+	for i, a := range aliases {
+		if len(a.Alias) > aliasWidth {
+			aliasWidth = len(a.Alias)
 		}
+		items[i] = dirAliasItem{a}
 	}
+	return items, aliasWidth
+}
 
-	fmtStr := fmt.Sprintf("  %%-%ds  %%-%ds ", maxLenAlias, maxLenPath+1)
-	for i, a := range m.Config.DirAliases {
-		if i == m.selectedIdx {
-			s += styles.selected.Render(fmt.Sprintf(fmtStr, a.Alias, a.Path))
-			s += "\n"
-			continue
-		}
+// Option configures a Model at construction time. See WithWatch.
+type Option func(*Model)
 
-		s += styles.rest.Render(fmt.Sprintf(fmtStr, a.Alias, a.Path))
-		s += "\n"
+// WithWatch makes the Model watch `configPath` for changes with fsnotify and
+// live-reload the alias list, instead of requiring a relaunch to pick up
+// edits made outside the finder. The watcher runs until `ctx` is done, so
+// callers must cancel it once they're done with the Model to avoid leaking
+// the watcher goroutine.
+func WithWatch(ctx context.Context, configPath string) Option {
+	return func(m *Model) {
+		m.watchCtx = ctx
+		m.watchPath = configPath
+	}
+}
 
-		if i >= 9 {
-			break
-		}
+// NewModel builds a Model from an already-loaded config.C so callers (and
+// tests) can drive the fuzzy finder without StartFzf touching disk.
+func NewModel(cfg config.C, opts ...Option) Model {
+	items, aliasWidth := buildItems(cfg.DirAliases)
+
+	dirList := list.New(items, itemDelegate{aliasWidth: aliasWidth}, 0, 0)
+	dirList.Title = "Which project do you want to open?"
+	dirList.SetShowStatusBar(false)
+	dirList.SetStatusBarItemName("project", "projects")
+
+	m := Model{
+		Config:     cfg,
+		list:       dirList,
+		aliasWidth: aliasWidth,
 	}
 
-	if m.helpShown {
-		s += "\n?         hide key bindings"
-		s += "\nctrl+n/↓  move selection down"
-		s += "\nctrl+p/↑  move selection up"
-		s += "\nctrl+w    clear search string"
-		s += "\nctrl+c    quit"
-	} else {
-		s += "\n?         show key bindings"
-		s += "\nctrl+c    quit"
+	for _, opt := range opts {
+		opt(&m)
 	}
-	return styles.window.Render(s) + "\n"
+
+	return m
 }
 
-func initialModel(configPath string) Model {
+// StartFzf is the entry point for the fuzzy finder. It loads the config,
+// runs the bubbletea program to completion and returns the path of the
+// project the user picked, or an empty string if they quit without picking one.
+func StartFzf(configPath string) (string, error) {
 	cfg, err := config.Read(configPath)
 	if err != nil {
-		panic(err)
+		return "", err
+	}
+
+	watchCtx, stopWatch := context.WithCancel(context.Background())
+	defer stopWatch()
+
+	finalModel, err := tea.NewProgram(NewModel(cfg, WithWatch(watchCtx, configPath))).Run()
+	if err != nil {
+		return "", err
 	}
-	return Model{
-		Config: cfg,
+
+	m, ok := finalModel.(Model)
+	if !ok {
+		return "", fmt.Errorf("fzf: unexpected model type %T returned from program", finalModel)
 	}
-}
 
-// StartFzf is the entry point for the fuzzy finder which spawns the bubbletea
-// program.
-func StartFzf(configPath string) *tea.Program {
-	return tea.NewProgram(initialModel(configPath))
+	return m.Selected, nil
 }
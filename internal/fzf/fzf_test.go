@@ -0,0 +1,78 @@
+package fzf
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/wipdev-tech/gopen/internal/config"
+)
+
+var ansiEscapes = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+func stripANSI(s string) string { return ansiEscapes.ReplaceAllString(s, "") }
+
+func TestMiddleEllipsis(t *testing.T) {
+	cases := []struct {
+		name  string
+		in    string
+		width int
+		want  string
+	}{
+		{"fits within width", "short", 10, "short"},
+		{"fits exactly", "short", 5, "short"},
+		{"truncates with ellipsis", "~/projects/very/long/path", 10, "~/pr…/path"},
+		{"width of one", "anything", 1, "…"},
+		{"non-positive width", "anything", 0, ""},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := middleEllipsis(c.in, c.width)
+			if got != c.want {
+				t.Errorf("middleEllipsis(%q, %d) = %q, want %q", c.in, c.width, got, c.want)
+			}
+		})
+	}
+}
+
+func TestHighlightMatch(t *testing.T) {
+	cases := []struct {
+		name    string
+		str     string
+		indexes []int
+	}{
+		{"no matches", "dotfiles", nil},
+		{"every rune matches", "gopen", []int{0, 1, 2, 3, 4}},
+		{"scattered matches", "monorepo", []int{0, 2, 4}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := stripANSI(highlightMatch(c.str, c.indexes))
+			if got != c.str {
+				t.Errorf("highlightMatch(%q, %v) stripped = %q, want %q", c.str, c.indexes, got, c.str)
+			}
+		})
+	}
+}
+
+func TestNewModel(t *testing.T) {
+	cfg := config.C{
+		DirAliases: []config.DirAlias{
+			{Alias: "dots", Path: "/home/user/dotfiles"},
+			{Alias: "gopen", Path: "/home/user/code/gopen"},
+		},
+	}
+
+	m := NewModel(cfg)
+
+	if m.Config.DirAliases[0].Alias != cfg.DirAliases[0].Alias {
+		t.Errorf("Config = %+v, want %+v", m.Config, cfg)
+	}
+	if want := len("gopen"); m.aliasWidth != want {
+		t.Errorf("aliasWidth = %d, want %d", m.aliasWidth, want)
+	}
+	if got := len(m.list.Items()); got != len(cfg.DirAliases) {
+		t.Errorf("list has %d items, want %d", got, len(cfg.DirAliases))
+	}
+}
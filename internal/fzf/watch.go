@@ -0,0 +1,94 @@
+package fzf
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/wipdev-tech/gopen/internal/config"
+)
+
+// debounceInterval coalesces bursts of filesystem events (e.g. an editor
+// writing a temp file then renaming it over the original) into one reload.
+const debounceInterval = 200 * time.Millisecond
+
+// watchConfig watches the directory containing `path` for changes and
+// returns a channel of freshly re-read configs, one per settled change. The
+// directory, not the file, is watched because editors commonly replace a
+// file by renaming a temp file over it, which some platforms only report on
+// the containing directory. The watcher and its goroutine are torn down as
+// soon as `ctx` is done.
+func watchConfig(ctx context.Context, path string) (<-chan config.C, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		watcher.Close()
+		return nil, err
+	}
+
+	out := make(chan config.C)
+	go debounceConfigChanges(ctx, watcher, path, out)
+	return out, nil
+}
+
+// debounceConfigChanges reads fsnotify events for `path` until `ctx` is done
+// or the watcher is closed, re-reading the config and sending it on `out`
+// once `debounceInterval` passes without a further event.
+func debounceConfigChanges(ctx context.Context, watcher *fsnotify.Watcher, path string, out chan<- config.C) {
+	defer watcher.Close()
+	defer close(out)
+
+	changed := make(chan config.C)
+
+	var timer *time.Timer
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if filepath.Clean(event.Name) != filepath.Clean(path) {
+				continue
+			}
+
+			if timer != nil {
+				timer.Stop()
+			}
+			timer = time.AfterFunc(debounceInterval, func() {
+				cfg, err := config.Read(path)
+				if err != nil {
+					return
+				}
+				select {
+				case changed <- cfg:
+				case <-ctx.Done():
+				}
+			})
+
+		case cfg := <-changed:
+			select {
+			case out <- cfg:
+			case <-ctx.Done():
+				return
+			}
+
+		case _, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+		}
+	}
+}
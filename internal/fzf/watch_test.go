@@ -0,0 +1,83 @@
+package fzf
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/wipdev-tech/gopen/internal/config"
+)
+
+func writeConfig(t *testing.T, path string, aliases []config.DirAlias) {
+	t.Helper()
+
+	data, err := json.Marshal(config.C{DirAliases: aliases})
+	if err != nil {
+		t.Fatalf("marshal config: %v", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+}
+
+func TestWatchConfigDebouncesBurstsIntoOneReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	writeConfig(t, path, []config.DirAlias{{Alias: "old", Path: "/old"}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := watchConfig(ctx, path)
+	if err != nil {
+		t.Fatalf("watchConfig: %v", err)
+	}
+
+	// A burst of rapid writes, like an editor saving, should coalesce into a
+	// single reload carrying only the final content.
+	for i := 0; i < 3; i++ {
+		writeConfig(t, path, []config.DirAlias{{Alias: "new", Path: "/new"}})
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	select {
+	case cfg := <-events:
+		if len(cfg.DirAliases) != 1 || cfg.DirAliases[0].Alias != "new" {
+			t.Errorf("got %+v, want a single reload with alias %q", cfg, "new")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for debounced reload")
+	}
+
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Error("expected only one reload for the whole burst")
+		}
+	case <-time.After(300 * time.Millisecond):
+	}
+}
+
+func TestWatchConfigStopsOnContextCancel(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	writeConfig(t, path, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, err := watchConfig(ctx, path)
+	if err != nil {
+		t.Fatalf("watchConfig: %v", err)
+	}
+
+	cancel()
+
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Error("expected events channel to close after context cancellation")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for watcher teardown")
+	}
+}